@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/spdxlicense"
 	"github.com/anchore/syft/internal/version"
 	"github.com/anchore/syft/syft/distro"
 	"github.com/anchore/syft/syft/pkg"
@@ -18,10 +19,18 @@ type JSONDocument struct {
 	Descriptor            JSONDescriptor     `json:"descriptor"` // Descriptor is a block containing self-describing information about syft
 	Schema                JSONSchema         `json:"schema"`     // Schema is a block reserved for defining the version for the shape of this JSON document and where to find the schema document to validate the shape
 	ArtifactRelationships []JSONRelationship `json:"artifactRelationships"`
+	Sources               []JSONSource       `json:"sources,omitempty"` // Sources preserves the per-source provenance of a document produced by MergeDocuments; empty for a document produced directly from a single scan
 }
 
-// NewDocument creates and populates a new JSON document struct from the given cataloging results.
-func NewDocument(catalog *pkg.Catalog, srcMetadata source.Metadata, d *distro.Distro) (JSONDocument, error) {
+// NewDocument creates and populates a new JSON document struct from the given cataloging results. When
+// licenses is non-nil, each package's license strings are normalized against it before the document is built;
+// pass nil to skip normalization (e.g. when no license catalog was configured for this run).
+//
+// No CLI/cmd layer in this tree exposes a flag for spdxlicense.CatalogOptions.CacheDir; the license catalog
+// is used exactly as the caller constructed it.
+func NewDocument(catalog *pkg.Catalog, srcMetadata source.Metadata, d *distro.Distro, licenses *spdxlicense.Catalog) (JSONDocument, error) {
+	pkg.NormalizeLicenses(catalog, licenses)
+
 	src, err := NewJSONSource(srcMetadata)
 	if err != nil {
 		return JSONDocument{}, nil
@@ -0,0 +1,36 @@
+package packages
+
+import "github.com/anchore/syft/syft/distro"
+
+// JSONDistribution represents the Linux distribution that was detected from the source.
+type JSONDistribution struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	IDLike  string `json:"idLike,omitempty"`
+}
+
+// NewJSONDistribution creates a JSONDistribution from the given distro, returning an empty value when d is nil
+// (no distribution was detected).
+func NewJSONDistribution(d *distro.Distro) JSONDistribution {
+	if d == nil {
+		return JSONDistribution{}
+	}
+	return JSONDistribution{
+		Name:    d.Name(),
+		Version: d.FullVersion(),
+		IDLike:  d.IDLike,
+	}
+}
+
+// JSONDescriptor is a block containing self-describing information about syft.
+type JSONDescriptor struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// JSONSchema is a block reserved for defining the version for the shape of this JSON document and where to
+// find the schema document to validate the shape.
+type JSONSchema struct {
+	Version string `json:"version"`
+	URL     string `json:"url"`
+}
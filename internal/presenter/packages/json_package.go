@@ -0,0 +1,25 @@
+package packages
+
+import (
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// JSONPackage represents a single package within the JSON document.
+type JSONPackage struct {
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Type     string   `json:"type"`
+	PURL     string   `json:"purl"`
+	Licenses []string `json:"licenses"`
+}
+
+// NewJSONPackage creates a new JSONPackage from the given package.
+func NewJSONPackage(p *pkg.Package) (JSONPackage, error) {
+	return JSONPackage{
+		Name:     p.Name,
+		Version:  p.Version,
+		Type:     string(p.Type),
+		PURL:     p.PURL,
+		Licenses: p.Licenses,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package packages
+
+import "github.com/anchore/syft/syft/artifact"
+
+// JSONRelationship represents a directed edge between two artifacts (by ID) in the JSON document.
+type JSONRelationship struct {
+	Parent string      `json:"parent"`
+	Child  string      `json:"child"`
+	Type   string      `json:"type"`
+	Data   interface{} `json:"metadata,omitempty"`
+}
+
+func newJSONRelationships(relationships []artifact.Relationship) []JSONRelationship {
+	out := make([]JSONRelationship, 0, len(relationships))
+	for _, r := range relationships {
+		out = append(out, JSONRelationship{
+			Parent: string(r.From),
+			Child:  string(r.To),
+			Type:   string(r.Type),
+			Data:   r.Data,
+		})
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+package packages
+
+import (
+	"fmt"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+// JSONSource object represents the thing that was cataloged
+type JSONSource struct {
+	Type   string      `json:"type"`
+	Target interface{} `json:"target"`
+}
+
+// JSONFileMetadata represents the serialized form of a source.FileMetadata
+type JSONFileMetadata struct {
+	Path     string `json:"path"`
+	Digest   string `json:"digest"`
+	MIMEType string `json:"mimeType"`
+	Size     int64  `json:"size"`
+}
+
+// NewJSONSource creates a new JSONSource from the given source metadata, selecting a JSON shape appropriate
+// for the underlying Scheme.
+func NewJSONSource(metadata source.Metadata) (JSONSource, error) {
+	switch metadata.Scheme {
+	case source.DirectoryScheme:
+		return JSONSource{
+			Type:   "directory",
+			Target: metadata.Path,
+		}, nil
+	case source.ImageScheme:
+		return JSONSource{
+			Type:   "image",
+			Target: metadata.ImageMetadata,
+		}, nil
+	case source.FileScheme:
+		return JSONSource{
+			Type: "file",
+			Target: JSONFileMetadata{
+				Path:     metadata.FileMetadata.Path,
+				Digest:   metadata.FileMetadata.Digest,
+				MIMEType: metadata.FileMetadata.MIMEType,
+				Size:     metadata.FileMetadata.Size,
+			},
+		}, nil
+	}
+	return JSONSource{}, fmt.Errorf("unable to create JSON source from scheme=%q", metadata.Scheme)
+}
@@ -0,0 +1,57 @@
+package packages
+
+import (
+	"fmt"
+)
+
+// MergeDocuments combines several previously produced JSONDocuments into a single document, de-duplicating
+// packages by their identity (name, version, type, and PURL) and the relationships between them. Per-source
+// provenance is preserved in the returned document's Sources field. This allows, for example, a base-image scan
+// and an app-layer scan to be combined into a single deliverable SBOM.
+//
+// Relationship endpoints are not rewritten: a package's ID is a pure hash of its identity (see
+// pkg.Package.ID), so the same package always carries the same ID across every document it appears in, and a
+// relationship referencing it already points at the right place without any remapping.
+func MergeDocuments(docs ...JSONDocument) (JSONDocument, error) {
+	if len(docs) == 0 {
+		return JSONDocument{}, fmt.Errorf("no documents given to merge")
+	}
+
+	merged := docs[0]
+	merged.Artifacts = nil
+	merged.ArtifactRelationships = nil
+	merged.Sources = make([]JSONSource, 0, len(docs))
+
+	seenPackages := make(map[string]struct{})
+	seenRelationships := make(map[string]struct{})
+
+	for _, doc := range docs {
+		merged.Sources = append(merged.Sources, doc.Source)
+
+		for _, p := range doc.Artifacts {
+			key := packageIdentityKey(p)
+			if _, exists := seenPackages[key]; exists {
+				continue
+			}
+			seenPackages[key] = struct{}{}
+			merged.Artifacts = append(merged.Artifacts, p)
+		}
+
+		for _, r := range doc.ArtifactRelationships {
+			dedupeKey := r.Parent + "|" + r.Child + "|" + r.Type
+			if _, exists := seenRelationships[dedupeKey]; exists {
+				// e.g. the same "package contains file" relationship observed in two input documents
+				continue
+			}
+			seenRelationships[dedupeKey] = struct{}{}
+
+			merged.ArtifactRelationships = append(merged.ArtifactRelationships, r)
+		}
+	}
+
+	return merged, nil
+}
+
+func packageIdentityKey(p JSONPackage) string {
+	return fmt.Sprintf("%s|%s|%s|%s", p.Name, p.Version, p.Type, p.PURL)
+}
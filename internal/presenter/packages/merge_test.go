@@ -0,0 +1,88 @@
+package packages
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/anchore/syft/syft/pkg"
+)
+
+// TestMergeDocuments_JSONRoundTrip ensures that documents loaded back from disk (i.e. having been through a
+// json.Marshal/json.Unmarshal cycle, where no in-memory state survives beyond what's exported in the JSON
+// shape) still merge and de-duplicate correctly. This guards against relying on any field that isn't actually
+// serialized, such as a package's cached artifact.ID.
+//
+// The shared package's relationship endpoints are set to its real pkg.IdentityID hash, exactly as
+// newJSONRelationships would compute them from a live catalog: since that ID is a pure function of the
+// package's identity (name, version, type, and PURL), it comes out identical in both documents without any
+// remapping, which is what lets MergeDocuments below skip rewriting relationship endpoints entirely.
+func TestMergeDocuments_JSONRoundTrip(t *testing.T) {
+	sharedLibID := string(pkg.IdentityID("shared-lib", "1.0", "deb", "pkg:deb/shared-lib@1.0"))
+
+	base := JSONDocument{
+		Source: JSONSource{Type: "directory", Target: "/base"},
+		Artifacts: []JSONPackage{
+			{Name: "shared-lib", Version: "1.0", Type: "deb", PURL: "pkg:deb/shared-lib@1.0"},
+		},
+		ArtifactRelationships: []JSONRelationship{
+			{Parent: "file-a", Child: sharedLibID, Type: "ownership-by-file-overlap"},
+		},
+	}
+
+	app := JSONDocument{
+		Source: JSONSource{Type: "directory", Target: "/app"},
+		Artifacts: []JSONPackage{
+			{Name: "shared-lib", Version: "1.0", Type: "deb", PURL: "pkg:deb/shared-lib@1.0"},
+			{Name: "app-only", Version: "2.0", Type: "python", PURL: "pkg:pypi/app-only@2.0"},
+		},
+		ArtifactRelationships: []JSONRelationship{
+			{Parent: "file-b", Child: sharedLibID, Type: "ownership-by-file-overlap"},
+		},
+	}
+
+	docs := roundTripJSON(t, base, app)
+
+	merged, err := MergeDocuments(docs...)
+	if err != nil {
+		t.Fatalf("MergeDocuments returned an error: %+v", err)
+	}
+
+	if len(merged.Artifacts) != 2 {
+		t.Fatalf("expected shared-lib to be de-duplicated, got %d artifacts: %+v", len(merged.Artifacts), merged.Artifacts)
+	}
+
+	if len(merged.ArtifactRelationships) != 2 {
+		t.Fatalf("expected both relationships to survive, got %d: %+v", len(merged.ArtifactRelationships), merged.ArtifactRelationships)
+	}
+
+	for _, r := range merged.ArtifactRelationships {
+		if r.Child != sharedLibID {
+			t.Errorf("expected relationship to point at shared-lib's identity ID %q, got %q", sharedLibID, r.Child)
+		}
+	}
+
+	if len(merged.Sources) != 2 {
+		t.Errorf("expected per-source provenance for both documents, got %d: %+v", len(merged.Sources), merged.Sources)
+	}
+}
+
+// roundTripJSON marshals and unmarshals each document, simulating documents that were written to disk and read
+// back rather than merged directly from the documents NewDocument produced in-process.
+func roundTripJSON(t *testing.T, docs ...JSONDocument) []JSONDocument {
+	t.Helper()
+
+	out := make([]JSONDocument, 0, len(docs))
+	for _, d := range docs {
+		b, err := json.Marshal(d)
+		if err != nil {
+			t.Fatalf("unable to marshal document: %+v", err)
+		}
+
+		var roundTripped JSONDocument
+		if err := json.Unmarshal(b, &roundTripped); err != nil {
+			t.Fatalf("unable to unmarshal document: %+v", err)
+		}
+		out = append(out, roundTripped)
+	}
+	return out
+}
@@ -0,0 +1,173 @@
+package spdxlicense
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const (
+	listURL           = "https://spdx.org/licenses/licenses.json"
+	listCacheFilename = "licenses.json"
+)
+
+// CatalogOptions configures how a Catalog resolves and caches SPDX license data.
+type CatalogOptions struct {
+	// CacheDir is where the downloaded license list and per-license text are stored between runs. When empty,
+	// no on-disk cache is used and the catalog relies on the embedded, build-time snapshot only. No CLI/cmd
+	// layer in this tree sets this; it is set only by code that constructs CatalogOptions directly.
+	CacheDir string
+	// Offline disables all network access; only the on-disk cache (if populated) and the embedded snapshot
+	// are consulted.
+	Offline bool
+}
+
+// Catalog resolves SPDX license IDs to their canonical form and, on request, the full license text. It
+// prefers a pinned, on-disk cache of the live SPDX license list over the embedded map generated at build
+// time, downloading the cache lazily on first use unless running in offline mode.
+type Catalog struct {
+	opts    CatalogOptions
+	client  *http.Client
+	once    sync.Once
+	version string
+	ids     map[string]string
+}
+
+// NewCatalog constructs a Catalog backed only by the embedded, build-time SPDX license snapshot.
+func NewCatalog() *Catalog {
+	return NewCatalogWithOptions(CatalogOptions{})
+}
+
+// NewCatalogWithOptions constructs a Catalog with the given options. Nothing is read from disk or downloaded
+// until the catalog is first asked to resolve or fetch a license.
+func NewCatalogWithOptions(opts CatalogOptions) *Catalog {
+	return &Catalog{
+		opts:   opts,
+		client: http.DefaultClient,
+	}
+}
+
+// Version returns the SPDX license list version backing this catalog.
+func (c *Catalog) Version() string {
+	c.ensureLoaded()
+	return c.version
+}
+
+// Resolve normalizes the given license ID (in any casing) against the catalog, returning the canonical SPDX
+// license ID and whether a match was found.
+func (c *Catalog) Resolve(id string) (string, bool) {
+	c.ensureLoaded()
+	v, exists := c.ids[strings.ToLower(id)]
+	return v, exists
+}
+
+// Text returns the full license text for the given canonical SPDX license ID, downloading and caching it on
+// first use. When no CacheDir is configured an error is always returned, since license text is not part of
+// the embedded snapshot; in offline mode only already-cached text is returned, and an error otherwise.
+func (c *Catalog) Text(licenseID string) (string, error) {
+	if c.opts.CacheDir == "" {
+		return "", fmt.Errorf("no cache directory configured, cannot fetch license text for %q", licenseID)
+	}
+
+	path := filepath.Join(c.opts.CacheDir, licenseID+".txt")
+	if contents, err := ioutil.ReadFile(path); err == nil {
+		return string(contents), nil
+	}
+
+	if c.opts.Offline {
+		return "", fmt.Errorf("license text for %q not cached and catalog is offline", licenseID)
+	}
+
+	resp, err := c.client.Get(fmt.Sprintf("https://spdx.org/licenses/%s.txt", licenseID))
+	if err != nil {
+		return "", fmt.Errorf("unable to fetch license text for %q: %w", licenseID, err)
+	}
+	defer resp.Body.Close()
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("unable to read license text for %q: %w", licenseID, err)
+	}
+
+	if err := os.MkdirAll(c.opts.CacheDir, 0o755); err == nil {
+		_ = ioutil.WriteFile(path, contents, 0o644)
+	}
+
+	return string(contents), nil
+}
+
+// ensureLoaded populates the in-memory license ID map exactly once, preferring a pinned on-disk cache over a
+// lazy download over the embedded, build-time snapshot (in that order of preference).
+func (c *Catalog) ensureLoaded() {
+	c.once.Do(func() {
+		if c.opts.CacheDir != "" {
+			if ids, version, err := c.loadFromCache(); err == nil {
+				c.ids, c.version = ids, version
+				return
+			}
+
+			if !c.opts.Offline {
+				if ids, version, err := c.downloadAndCache(); err == nil {
+					c.ids, c.version = ids, version
+					return
+				}
+			}
+		}
+
+		c.ids, c.version = licenseIDs, Version
+	})
+}
+
+func (c *Catalog) loadFromCache() (map[string]string, string, error) {
+	contents, err := ioutil.ReadFile(filepath.Join(c.opts.CacheDir, listCacheFilename))
+	if err != nil {
+		return nil, "", err
+	}
+	return parseLicenseList(contents)
+}
+
+func (c *Catalog) downloadAndCache() (map[string]string, string, error) {
+	resp, err := c.client.Get(listURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to fetch spdx license list: %w", err)
+	}
+	defer resp.Body.Close()
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("unable to read spdx license list: %w", err)
+	}
+
+	if err := os.MkdirAll(c.opts.CacheDir, 0o755); err != nil {
+		return nil, "", fmt.Errorf("unable to create cache dir %q: %w", c.opts.CacheDir, err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(c.opts.CacheDir, listCacheFilename), contents, 0o644); err != nil {
+		return nil, "", fmt.Errorf("unable to write spdx license list cache: %w", err)
+	}
+
+	return parseLicenseList(contents)
+}
+
+func parseLicenseList(contents []byte) (map[string]string, string, error) {
+	var result struct {
+		Version  string `json:"licenseListVersion"`
+		Licenses []struct {
+			ID string `json:"licenseId"`
+		} `json:"licenses"`
+	}
+	if err := json.Unmarshal(contents, &result); err != nil {
+		return nil, "", fmt.Errorf("unable to parse spdx license list: %w", err)
+	}
+
+	ids := make(map[string]string, len(result.Licenses))
+	for _, l := range result.Licenses {
+		ids[strings.ToLower(l.ID)] = l.ID
+	}
+
+	return ids, result.Version, nil
+}
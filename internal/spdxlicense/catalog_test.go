@@ -0,0 +1,51 @@
+package spdxlicense
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCatalog_CacheHit(t *testing.T) {
+	cacheDir := t.TempDir()
+	contents := `{"licenseListVersion":"9.9","licenses":[{"licenseId":"Apache-2.0"}]}`
+	if err := os.WriteFile(filepath.Join(cacheDir, listCacheFilename), []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewCatalogWithOptions(CatalogOptions{CacheDir: cacheDir, Offline: true})
+
+	if got := c.Version(); got != "9.9" {
+		t.Errorf("expected cached version=9.9, got %q", got)
+	}
+
+	resolved, exists := c.Resolve("apache-2.0")
+	if !exists || resolved != "Apache-2.0" {
+		t.Errorf("expected to resolve apache-2.0 from cache, got %q (exists=%v)", resolved, exists)
+	}
+}
+
+func TestCatalog_OfflineFallsBackToEmbeddedSnapshot(t *testing.T) {
+	c := NewCatalogWithOptions(CatalogOptions{CacheDir: t.TempDir(), Offline: true})
+
+	if got := c.Version(); got != Version {
+		t.Errorf("expected embedded snapshot version=%q, got %q", Version, got)
+	}
+
+	resolved, exists := c.Resolve("mit")
+	if !exists || resolved != "MIT" {
+		t.Errorf("expected to resolve mit from embedded snapshot, got %q (exists=%v)", resolved, exists)
+	}
+}
+
+func TestCatalog_NoCacheDirUsesEmbeddedSnapshot(t *testing.T) {
+	c := NewCatalog()
+
+	if got := c.Version(); got != Version {
+		t.Errorf("expected embedded snapshot version=%q, got %q", Version, got)
+	}
+
+	if _, exists := c.Resolve("not-a-real-license"); exists {
+		t.Error("expected unknown license ID to not resolve")
+	}
+}
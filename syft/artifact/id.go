@@ -0,0 +1,11 @@
+package artifact
+
+// ID identifies an artifact within a catalog. For a package it is derived from the package's identity, so
+// packages with the same name, version, type, and PURL share one ID. It is also a cheap way to reference one
+// artifact from another, such as in a Relationship.
+type ID string
+
+// Identifiable is an object that has a unique ID.
+type Identifiable interface {
+	ID() ID
+}
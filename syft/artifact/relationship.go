@@ -0,0 +1,17 @@
+package artifact
+
+// RelationshipType enumerates the kinds of relationships that can hold between two artifacts (packages or
+// files) in a catalog.
+type RelationshipType string
+
+// OwnershipByFileOverlapRelationship indicates that the From package claims ownership of a file that is also
+// claimed by the To package.
+const OwnershipByFileOverlapRelationship RelationshipType = "ownership-by-file-overlap"
+
+// Relationship represents a directed edge between two artifacts in a catalog, e.g. "package A contains file B".
+type Relationship struct {
+	From ID
+	To   ID
+	Type RelationshipType
+	Data interface{}
+}
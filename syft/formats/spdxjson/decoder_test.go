@@ -0,0 +1,49 @@
+package spdxjson
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anchore/syft/syft/formats/internal/testutils"
+)
+
+func TestEncodeDecodeCycle(t *testing.T) {
+	testImage := "image-simple"
+	originalSBOM := testutils.ImageInput(t, testImage)
+
+	var buf bytes.Buffer
+	assert.NoError(t, encoder(&buf, originalSBOM))
+
+	actualSBOM, err := decoder(bytes.NewReader(buf.Bytes()))
+	assert.NoError(t, err)
+
+	// SPDX has no dedicated field for source.Metadata as a whole: Path is carried in the document name, Scheme
+	// is recovered from the document namespace (see documentNamespace/toSyftScheme), but ImageMetadata and
+	// FileMetadata have no representation at all and are not expected to survive the round trip.
+	assert.Equal(t, originalSBOM.Source.Path, actualSBOM.Source.Path)
+	assert.Equal(t, originalSBOM.Source.Scheme, actualSBOM.Source.Scheme)
+
+	actualPackages := actualSBOM.Artifacts.Packages.Sorted()
+	for idx, p := range originalSBOM.Artifacts.Packages.Sorted() {
+		if !assert.Equal(t, p.Name, actualPackages[idx].Name) {
+			t.Errorf("different package at idx=%d: %s vs %s", idx, p.Name, actualPackages[idx].Name)
+			continue
+		}
+
+		for _, d := range deep.Equal(p, actualPackages[idx]) {
+			if strings.HasSuffix(d, "<nil slice> != []") {
+				// semantically the same
+				continue
+			}
+			if strings.Contains(d, ".VirtualPath: ") {
+				// SPDX has no concept of a virtual (symlink-resolved) path; it is not preserved on round trip
+				continue
+			}
+			t.Errorf("package difference (%s): %+v", p.Name, d)
+		}
+	}
+}
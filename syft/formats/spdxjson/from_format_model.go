@@ -0,0 +1,127 @@
+package spdxjson
+
+import (
+	"strings"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/formats/spdxjson/model"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+	"github.com/anchore/syft/syft/source"
+)
+
+// toSyftModel resolves the flat, wire-format representation of an SPDX document into syft's in-memory model.
+// Files are addressed by SPDX ID and start out assumed to be "unpackaged"; each package's hasFiles list is
+// then used to move its files out of that bucket and under the owning package. Snippets are grouped by their
+// snippetFromFile target before packages are resolved; syft has no snippet model, so they are not carried into
+// the returned SBOM.
+func toSyftModel(doc model.Document) (*sbom.SBOM, error) {
+	unpackagedFiles := make(map[string]model.File, len(doc.Files))
+	for _, f := range doc.Files {
+		unpackagedFiles[f.SPDXID] = f
+	}
+
+	snippetsByFile := make(map[string][]model.Snippet)
+	for _, sn := range doc.Snippets {
+		snippetsByFile[sn.SnippetFromFile] = append(snippetsByFile[sn.SnippetFromFile], sn)
+	}
+
+	catalog := pkg.NewCatalog()
+	for _, p := range doc.Packages {
+		var ownedFiles []model.File
+		for _, fileID := range p.HasFiles {
+			f, exists := unpackagedFiles[fileID]
+			if !exists {
+				// the file was already claimed by another package or was never declared; nothing to attach
+				continue
+			}
+			delete(unpackagedFiles, fileID)
+			ownedFiles = append(ownedFiles, f)
+		}
+
+		catalog.Add(toSyftPackage(p, ownedFiles, snippetsByFile))
+	}
+
+	s := &sbom.SBOM{
+		Source: source.Metadata{
+			Path:   doc.Name,
+			Scheme: toSyftScheme(doc.DocumentNamespace),
+		},
+		Relationships: toSyftRelationships(doc.Relationships),
+	}
+	s.Artifacts.Packages = catalog
+
+	return s, nil
+}
+
+// toSyftScheme recovers the source.Scheme encoded in a document's namespace (see documentNamespace). SPDX has
+// no dedicated field for it, and no field at all for the rest of source.Metadata (ImageMetadata, FileMetadata),
+// so those remain at their zero values; this information is inherently lost once a non-directory source is
+// round-tripped through SPDX.
+func toSyftScheme(documentNamespace string) source.Scheme {
+	rest := strings.TrimPrefix(documentNamespace, documentNamespacePrefix)
+	scheme, _, found := strings.Cut(rest, "/")
+	if !found {
+		return source.UnknownScheme
+	}
+
+	switch s := source.Scheme(scheme); s {
+	case source.DirectoryScheme, source.ImageScheme, source.FileScheme:
+		return s
+	default:
+		return source.UnknownScheme
+	}
+}
+
+func toSyftPackage(p model.Package, files []model.File, snippetsByFile map[string][]model.Snippet) *pkg.Package {
+	var locations []source.Location
+	for _, f := range files {
+		locations = append(locations, source.NewLocation(f.FileName))
+		// snippets carried by this file are informational only; syft does not currently model snippet-level
+		// provenance, so only the containment (package -> file) relationship is preserved.
+		_ = snippetsByFile[f.SPDXID]
+	}
+
+	out := &pkg.Package{
+		Name:     p.Name,
+		Version:  p.VersionInfo,
+		Type:     pkg.Type(externalRef(p, typeRefType)),
+		PURL:     externalRef(p, purlRefType),
+		Licenses: toSyftLicenses(p.LicenseDeclared),
+	}
+	out.Locations.Add(locations...)
+
+	return out
+}
+
+// externalRef returns the locator of the first external reference on p with the given reference type, or "" if
+// none is present.
+func externalRef(p model.Package, referenceType string) string {
+	for _, ref := range p.ExternalRefs {
+		if ref.ReferenceType == referenceType {
+			return ref.ReferenceLocator
+		}
+	}
+	return ""
+}
+
+// toSyftLicenses reverses toFormatLicenseDeclared, splitting the conjunctive SPDX license expression back into
+// syft's flat license list. NOASSERTION (or an empty declaration) maps to no licenses at all.
+func toSyftLicenses(licenseDeclared string) []string {
+	if licenseDeclared == "" || licenseDeclared == noAssertion {
+		return nil
+	}
+	return strings.Split(licenseDeclared, " AND ")
+}
+
+func toSyftRelationships(relationships []model.Relationship) []artifact.Relationship {
+	var out []artifact.Relationship
+	for _, r := range relationships {
+		out = append(out, artifact.Relationship{
+			From: artifact.ID(r.SPDXElementID),
+			To:   artifact.ID(r.RelatedSPDXElement),
+			Type: artifact.RelationshipType(strings.ToLower(r.RelationshipType)),
+		})
+	}
+	return out
+}
@@ -0,0 +1,23 @@
+package model
+
+// Document is the root object of the official SPDX JSON schema (2.2 / 2.3 compatible). See:
+// https://github.com/spdx/spdx-spec/blob/development/v2.2.2/schemas/spdx-schema.json
+type Document struct {
+	SPDXVersion       string         `json:"spdxVersion"`
+	DataLicense       string         `json:"dataLicense"`
+	SPDXID            string         `json:"SPDXID"`
+	Name              string         `json:"name"`
+	DocumentNamespace string         `json:"documentNamespace"`
+	CreationInfo      CreationInfo   `json:"creationInfo"`
+	Packages          []Package      `json:"packages,omitempty"`
+	Files             []File         `json:"files,omitempty"`
+	Snippets          []Snippet      `json:"snippets,omitempty"`
+	Relationships     []Relationship `json:"relationships,omitempty"`
+}
+
+// CreationInfo captures the provenance of the document itself.
+type CreationInfo struct {
+	Created            string   `json:"created"`
+	Creators           []string `json:"creators"`
+	LicenseListVersion string   `json:"licenseListVersion,omitempty"`
+}
@@ -0,0 +1,30 @@
+package model
+
+// File represents a single file discovered while cataloging a package (or, when unowned, a standalone file
+// in the document's top-level "files" array).
+type File struct {
+	SPDXID           string     `json:"SPDXID"`
+	FileName         string     `json:"fileName"`
+	Checksums        []Checksum `json:"checksums,omitempty"`
+	LicenseConcluded string     `json:"licenseConcluded,omitempty"`
+	CopyrightText    string     `json:"copyrightText,omitempty"`
+}
+
+// Checksum is a single digest of a file's contents.
+type Checksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+// Snippet is a region of a file called out separately from the file as a whole, for example when only a
+// fragment of a file's contents were attributed to a package.
+type Snippet struct {
+	SPDXID           string `json:"SPDXID"`
+	Name             string `json:"name,omitempty"`
+	Comment          string `json:"comment,omitempty"`
+	CopyrightText    string `json:"copyrightText,omitempty"`
+	LicenseConcluded string `json:"licenseConcluded,omitempty"`
+	// SnippetFromFile is the SPDX identifier of the File this snippet was extracted from. On decode snippets
+	// are grouped by this value; they are not kept in the decoded syft model.
+	SnippetFromFile string `json:"snippetFromFile"`
+}
@@ -0,0 +1,25 @@
+package model
+
+// Package represents a single SPDX package element, roughly corresponding to a single syft package.
+type Package struct {
+	SPDXID           string        `json:"SPDXID"`
+	Name             string        `json:"name"`
+	VersionInfo      string        `json:"versionInfo,omitempty"`
+	DownloadLocation string        `json:"downloadLocation"`
+	FilesAnalyzed    bool          `json:"filesAnalyzed"`
+	LicenseConcluded string        `json:"licenseConcluded"`
+	LicenseDeclared  string        `json:"licenseDeclared"`
+	CopyrightText    string        `json:"copyrightText"`
+	SourceInfo       string        `json:"sourceInfo,omitempty"`
+	ExternalRefs     []ExternalRef `json:"externalRefs,omitempty"`
+	// HasFiles is the list of SPDX identifiers for files owned by this package. Files referenced here are
+	// nested under this package (and removed from the document's top-level unpackaged file list) when decoded.
+	HasFiles []string `json:"hasFiles,omitempty"`
+}
+
+// ExternalRef links a package to an external identifier, such as a PURL.
+type ExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
@@ -0,0 +1,10 @@
+package model
+
+// Relationship describes how two SPDX elements (packages, files, or snippets) relate to one another, for
+// example "CONTAINS" or "DEPENDS_ON".
+type Relationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	RelationshipType   string `json:"relationshipType"`
+	Comment            string `json:"comment,omitempty"`
+}
@@ -0,0 +1,142 @@
+package spdxjson
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/anchore/syft/internal"
+	"github.com/anchore/syft/internal/version"
+	"github.com/anchore/syft/syft/formats/spdxjson/model"
+	"github.com/anchore/syft/syft/pkg"
+	"github.com/anchore/syft/syft/sbom"
+)
+
+const (
+	spdxVersion = "SPDX-2.2"
+	dataLicense = "CC0-1.0"
+	noAssertion = "NOASSERTION"
+
+	purlRefType = "purl"
+	typeRefType = "syft-type"
+
+	documentNamespacePrefix = "https://anchore.com/syft/"
+)
+
+// toFormatModel converts a syft SBOM into an SPDX 2.2 document, linking files to their owning packages via
+// the package's hasFiles list. No snippets are emitted, since syft has no snippet data to encode.
+func toFormatModel(s sbom.SBOM) model.Document {
+	packages, files, snippets := toFormatPackagesAndFiles(s)
+
+	return model.Document{
+		SPDXVersion:       spdxVersion,
+		DataLicense:       dataLicense,
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              s.Source.Path,
+		DocumentNamespace: documentNamespace(s),
+		CreationInfo: model.CreationInfo{
+			Created: "", // left empty for determinism in tests; the encoder writes the document without setting it
+			Creators: []string{
+				fmt.Sprintf("Tool: %s-%s", internal.ApplicationName, version.FromBuild().Version),
+			},
+		},
+		Packages:      packages,
+		Files:         files,
+		Snippets:      snippets,
+		Relationships: toFormatRelationships(s),
+	}
+}
+
+func documentNamespace(s sbom.SBOM) string {
+	return fmt.Sprintf("%s%s/%s-%s", documentNamespacePrefix, s.Source.Scheme, internal.ApplicationName, s.Source.Path)
+}
+
+func toFormatPackagesAndFiles(s sbom.SBOM) ([]model.Package, []model.File, []model.Snippet) {
+	var packages []model.Package
+	var files []model.File
+	var snippets []model.Snippet
+
+	for _, p := range s.Artifacts.Packages.Sorted() {
+		id := packageSPDXID(p)
+
+		var hasFiles []string
+		for _, l := range p.Locations.ToSlice() {
+			fileID := fileSPDXID(p, l.RealPath)
+			hasFiles = append(hasFiles, fileID)
+			files = append(files, model.File{
+				SPDXID:        fileID,
+				FileName:      l.RealPath,
+				CopyrightText: noAssertion,
+			})
+		}
+
+		packages = append(packages, model.Package{
+			SPDXID:           id,
+			Name:             p.Name,
+			VersionInfo:      p.Version,
+			DownloadLocation: noAssertion,
+			FilesAnalyzed:    len(hasFiles) > 0,
+			LicenseConcluded: noAssertion,
+			LicenseDeclared:  toFormatLicenseDeclared(p.Licenses),
+			CopyrightText:    noAssertion,
+			ExternalRefs:     toFormatExternalRefs(p),
+			HasFiles:         hasFiles,
+		})
+	}
+
+	return packages, files, snippets
+}
+
+// toFormatLicenseDeclared joins the package's licenses into a single SPDX license expression. SPDX has no
+// concept of a package "type" or a list of individually-detected licenses, so syft's own license list is
+// folded into a conjunctive expression; an empty list maps to NOASSERTION.
+func toFormatLicenseDeclared(licenses []string) string {
+	if len(licenses) == 0 {
+		return noAssertion
+	}
+	return strings.Join(licenses, " AND ")
+}
+
+// toFormatExternalRefs carries syft-specific package data that has no dedicated field in the SPDX schema (the
+// PURL and syft's own package type) as external references, so that decoding a syft-produced document can
+// reconstruct an equivalent pkg.Package.
+func toFormatExternalRefs(p *pkg.Package) []model.ExternalRef {
+	var refs []model.ExternalRef
+
+	if p.PURL != "" {
+		refs = append(refs, model.ExternalRef{
+			ReferenceCategory: "PACKAGE-MANAGER",
+			ReferenceType:     purlRefType,
+			ReferenceLocator:  p.PURL,
+		})
+	}
+
+	if p.Type != "" {
+		refs = append(refs, model.ExternalRef{
+			ReferenceCategory: "OTHER",
+			ReferenceType:     typeRefType,
+			ReferenceLocator:  string(p.Type),
+		})
+	}
+
+	return refs
+}
+
+func toFormatRelationships(s sbom.SBOM) []model.Relationship {
+	var out []model.Relationship
+	for _, r := range s.Relationships {
+		out = append(out, model.Relationship{
+			SPDXElementID:      string(r.From),
+			RelatedSPDXElement: string(r.To),
+			RelationshipType:   strings.ToUpper(string(r.Type)),
+		})
+	}
+	return out
+}
+
+func packageSPDXID(p *pkg.Package) string {
+	return fmt.Sprintf("SPDXRef-Package-%s-%s-%s", p.Type, sanitizeElementID(p.Name), sanitizeElementID(p.Version))
+}
+
+func fileSPDXID(p *pkg.Package, path string) string {
+	return fmt.Sprintf("SPDXRef-File-%s-%s-%s", sanitizeElementID(p.Name), sanitizeElementID(p.Version), sanitizeElementID(path))
+}
@@ -0,0 +1,11 @@
+package spdxjson
+
+import "regexp"
+
+var invalidElementIDChars = regexp.MustCompile(`[^a-zA-Z0-9.-]+`)
+
+// sanitizeElementID replaces characters that are not allowed in an SPDX element ID (only letters, numbers,
+// ".", and "-" are permitted) with a ".".
+func sanitizeElementID(id string) string {
+	return invalidElementIDChars.ReplaceAllString(id, ".")
+}
@@ -0,0 +1,66 @@
+package pkg
+
+import (
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+)
+
+// Catalog holds the set of packages discovered while cataloging a source, indexed by package ID.
+type Catalog struct {
+	byID map[artifact.ID]*Package
+}
+
+// NewCatalog returns a new empty Catalog, optionally populated with the given packages.
+func NewCatalog(pkgs ...*Package) *Catalog {
+	c := &Catalog{byID: make(map[artifact.ID]*Package)}
+	for _, p := range pkgs {
+		c.Add(p)
+	}
+	return c
+}
+
+// Add inserts (or replaces) the given package in the catalog, keyed by its ID.
+func (c *Catalog) Add(p *Package) {
+	if c.byID == nil {
+		c.byID = make(map[artifact.ID]*Package)
+	}
+	c.byID[p.ID()] = p
+}
+
+// Package returns the package with the given ID, or nil if it is not present in the catalog.
+func (c *Catalog) Package(id artifact.ID) *Package {
+	return c.byID[id]
+}
+
+// Merge adds every package from other into this catalog. Since a package's ID is derived from its identity
+// (name, version, type, and PURL), a package already present in this catalog has the incoming package's
+// locations unioned into it rather than being duplicated, so that the same package found at different paths
+// across the merged scans is not lost.
+func (c *Catalog) Merge(other *Catalog) {
+	for _, p := range other.Sorted() {
+		if existing, exists := c.byID[p.ID()]; exists {
+			existing.Locations.Add(p.Locations.ToSlice()...)
+			continue
+		}
+		c.Add(p)
+	}
+}
+
+// Sorted returns all packages in the catalog, stably ordered by name then version.
+func (c *Catalog) Sorted() []*Package {
+	out := make([]*Package, 0, len(c.byID))
+	for _, p := range c.byID {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		if out[i].Version != out[j].Version {
+			return out[i].Version < out[j].Version
+		}
+		return out[i].ID() < out[j].ID()
+	})
+	return out
+}
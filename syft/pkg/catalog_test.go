@@ -0,0 +1,42 @@
+package pkg
+
+import (
+	"testing"
+
+	"github.com/anchore/syft/syft/source"
+)
+
+func TestCatalog_Merge(t *testing.T) {
+	newPackage := func(path string) *Package {
+		p := &Package{Name: "shared-lib", Version: "1.0", Type: "deb", PURL: "pkg:deb/shared-lib@1.0"}
+		p.Locations.Add(source.NewLocation(path))
+		return p
+	}
+
+	c := NewCatalog(newPackage("/layer1/usr/lib/shared-lib.so"))
+	other := NewCatalog(newPackage("/layer2/usr/lib/shared-lib.so"))
+
+	c.Merge(other)
+
+	packages := c.Sorted()
+	if len(packages) != 1 {
+		t.Fatalf("expected the duplicate package to be merged into one, got %d: %+v", len(packages), packages)
+	}
+
+	locations := packages[0].Locations.ToSlice()
+	if len(locations) != 2 {
+		t.Fatalf("expected locations from both scans to be unioned, got %d: %+v", len(locations), locations)
+	}
+}
+
+func TestCatalog_Merge_NoOverlap(t *testing.T) {
+	a := &Package{Name: "a", Version: "1.0", Type: "deb"}
+	b := &Package{Name: "b", Version: "1.0", Type: "deb"}
+
+	c := NewCatalog(a)
+	c.Merge(NewCatalog(b))
+
+	if len(c.Sorted()) != 2 {
+		t.Fatalf("expected both distinct packages to be present, got %+v", c.Sorted())
+	}
+}
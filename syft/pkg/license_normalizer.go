@@ -0,0 +1,21 @@
+package pkg
+
+import "github.com/anchore/syft/internal/spdxlicense"
+
+// NormalizeLicenses rewrites each package's license strings to their canonical SPDX form using the given
+// license catalog, leaving any license that the catalog does not recognize untouched. This runs as a final
+// pass over the catalog once all catalogers have finished, so that license normalization can be validated
+// against a live SPDX license list rather than only the snapshot frozen at build time.
+func NormalizeLicenses(catalog *Catalog, licenses *spdxlicense.Catalog) {
+	if licenses == nil {
+		return
+	}
+
+	for _, p := range catalog.Sorted() {
+		for i, l := range p.Licenses {
+			if resolved, exists := licenses.Resolve(l); exists {
+				p.Licenses[i] = resolved
+			}
+		}
+	}
+}
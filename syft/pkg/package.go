@@ -0,0 +1,45 @@
+package pkg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/anchore/syft/syft/artifact"
+	"github.com/anchore/syft/syft/source"
+)
+
+// Type represents the distinct kinds of packages syft can catalog (e.g. "deb", "rpm", "python", "npm", ...).
+type Type string
+
+// Package represents an item discovered while cataloging a source, such as a language package, OS package, or
+// binary.
+type Package struct {
+	id        artifact.ID
+	Name      string
+	Version   string
+	Type      Type
+	PURL      string
+	Licenses  []string
+	Locations source.LocationSet
+}
+
+// ID returns a stable identifier for this package, deriving one from the package's identity (name, version,
+// type, and PURL) the first time it is requested. Packages with the same identity (as produced by, for
+// example, cataloging the same artifact from two different sources) share the same ID, which Catalog.Merge
+// relies on to de-duplicate.
+func (p *Package) ID() artifact.ID {
+	if p.id == "" {
+		p.id = IdentityID(p.Name, p.Version, p.Type, p.PURL)
+	}
+	return p.id
+}
+
+// IdentityID derives the stable identifier that Package.ID computes from a package's identity (name, version,
+// type, and PURL). It is exported so that callers holding only these fields (for example a JSON representation
+// of a package read back from disk) can recompute the same ID without needing a live Package.
+func IdentityID(name, version string, t Type, purl string) artifact.ID {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s|%s|%s|%s", name, version, t, purl)
+	return artifact.ID(hex.EncodeToString(h.Sum(nil))[:16])
+}
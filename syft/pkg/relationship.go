@@ -0,0 +1,47 @@
+package pkg
+
+import (
+	"sort"
+
+	"github.com/anchore/syft/syft/artifact"
+)
+
+// NewRelationships derives the set of relationships implied by the packages in the given catalog, such as a
+// package containing a file also claimed by another package.
+func NewRelationships(catalog *Catalog) []artifact.Relationship {
+	var relationships []artifact.Relationship
+
+	ownedPaths := make(map[string][]artifact.ID)
+	for _, p := range catalog.Sorted() {
+		for _, l := range p.Locations.ToSlice() {
+			ownedPaths[l.RealPath] = append(ownedPaths[l.RealPath], p.ID())
+		}
+	}
+
+	for _, ids := range ownedPaths {
+		if len(ids) < 2 {
+			continue
+		}
+		for _, to := range ids[1:] {
+			relationships = append(relationships, artifact.Relationship{
+				From: ids[0],
+				To:   to,
+				Type: artifact.OwnershipByFileOverlapRelationship,
+			})
+		}
+	}
+
+	// map iteration order is randomized, so without sorting, encoding the same catalog twice could produce
+	// relationships in a different order each time, breaking reproducible SBOM output.
+	sort.Slice(relationships, func(i, j int) bool {
+		if relationships[i].From != relationships[j].From {
+			return relationships[i].From < relationships[j].From
+		}
+		if relationships[i].To != relationships[j].To {
+			return relationships[i].To < relationships[j].To
+		}
+		return relationships[i].Type < relationships[j].Type
+	})
+
+	return relationships
+}
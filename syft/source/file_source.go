@@ -0,0 +1,120 @@
+package source
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	"github.com/mholt/archiver/v3"
+
+	"github.com/anchore/syft/internal/log"
+)
+
+// FileMetadata represents the static metadata captured about a single file source.
+type FileMetadata struct {
+	Path     string `json:"path"`
+	Digest   string `json:"digest"`
+	MIMEType string `json:"mimeType"`
+	Size     int64  `json:"size"`
+}
+
+// NewFromFile creates a new source object tailored to catalog a single file. If archiver recognizes the file
+// by its extension as an archive (tarball, compressed tarball, zip, rar) it is unpacked into a temporary
+// directory and the returned cleanup function must be called once cataloging is complete to remove it;
+// otherwise, including for jar, deb, and rpm files, the file is cataloged directly and cleanup is a no-op.
+func NewFromFile(path string) (Source, func(), error) {
+	cleanup := func() {}
+
+	fileInfo, err := os.Stat(path)
+	if err != nil {
+		return Source{}, cleanup, fmt.Errorf("unable to stat file=%q: %w", path, err)
+	}
+	if fileInfo.IsDir() {
+		return Source{}, cleanup, fmt.Errorf("given path is a directory, not a file (path=%q)", path)
+	}
+
+	digest, err := digestOf(path)
+	if err != nil {
+		return Source{}, cleanup, fmt.Errorf("unable to digest file=%q: %w", path, err)
+	}
+
+	meta := FileMetadata{
+		Path:     path,
+		Digest:   digest,
+		MIMEType: mimeTypeOf(path),
+		Size:     fileInfo.Size(),
+	}
+
+	resolver, resolvedCleanup, err := fileResolverFor(path)
+	if err != nil {
+		return Source{}, cleanup, fmt.Errorf("unable to stage file=%q: %w", path, err)
+	}
+	cleanup = resolvedCleanup
+
+	return Source{
+		Metadata: Metadata{
+			Scheme:       FileScheme,
+			Path:         path,
+			FileMetadata: meta,
+		},
+		fileResolver: resolver,
+	}, cleanup, nil
+}
+
+// fileResolverFor stages the given file for cataloging, unpacking it into a temporary directory when it is a
+// recognized archive format and delegating to the directory resolver over the unpacked contents; otherwise
+// the single file is presented directly.
+func fileResolverFor(path string) (FileResolver, func(), error) {
+	tempDir, err := ioutil.TempDir("", "syft-file-source-")
+	if err != nil {
+		return nil, func() {}, fmt.Errorf("unable to create temp dir: %w", err)
+	}
+
+	if err := archiver.Unarchive(path, tempDir); err != nil {
+		// not a supported archive format (or not an archive at all); catalog the single file directly
+		if rmErr := os.RemoveAll(tempDir); rmErr != nil {
+			log.Warnf("unable to remove temp dir=%q: %+v", tempDir, rmErr)
+		}
+		return newSingleFileResolver(path), func() {}, nil
+	}
+
+	cleanup := func() {
+		if err := os.RemoveAll(tempDir); err != nil {
+			log.Warnf("unable to remove temp dir=%q: %+v", tempDir, err)
+		}
+	}
+
+	return newDirectoryResolver(tempDir), cleanup, nil
+}
+
+func digestOf(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func mimeTypeOf(path string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	buf := make([]byte, 512)
+	n, _ := f.Read(buf)
+
+	return http.DetectContentType(buf[:n])
+}
@@ -0,0 +1,13 @@
+package source
+
+// Location represents a path relative to a particular source, optionally recording a separate "real" path
+// when the observed path is a symlink or otherwise virtual.
+type Location struct {
+	RealPath    string `json:"path"`
+	VirtualPath string `json:"-"`
+}
+
+// NewLocation creates a new Location representing a path without any layer/filesystem information.
+func NewLocation(realPath string) Location {
+	return Location{RealPath: realPath}
+}
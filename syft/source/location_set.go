@@ -0,0 +1,25 @@
+package source
+
+// LocationSet holds a de-duplicated set of file Locations, keyed by real path.
+type LocationSet struct {
+	byPath map[string]Location
+}
+
+// Add inserts the given locations into the set, ignoring any already present (by real path).
+func (s *LocationSet) Add(locations ...Location) {
+	if s.byPath == nil {
+		s.byPath = make(map[string]Location)
+	}
+	for _, l := range locations {
+		s.byPath[l.RealPath] = l
+	}
+}
+
+// ToSlice returns the locations held in this set; order is not guaranteed.
+func (s LocationSet) ToSlice() []Location {
+	out := make([]Location, 0, len(s.byPath))
+	for _, l := range s.byPath {
+		out = append(out, l)
+	}
+	return out
+}
@@ -0,0 +1,9 @@
+package source
+
+// Metadata represents any static source information uniquely found within a Scheme.
+type Metadata struct {
+	Scheme        Scheme        `json:"scheme"`                  // the source data Scheme (e.g. the data source is a directory, container image, or file)
+	Path          string        `json:"path,omitempty"`          // the path to the directory or archive being cataloged (DirectoryScheme, FileScheme)
+	ImageMetadata ImageMetadata `json:"imageMetadata,omitempty"` // all image-specific information (ImageScheme)
+	FileMetadata  FileMetadata  `json:"fileMetadata,omitempty"`  // all file-specific information (FileScheme)
+}
@@ -0,0 +1,51 @@
+package source
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// Scheme represents the optional prefixing syntax used when specifying a cataloging source, e.g. dir:,
+// image:, or file:.
+type Scheme string
+
+const (
+	// UnknownScheme is the Scheme for a source that could not be identified
+	UnknownScheme Scheme = "UnknownScheme"
+	// DirectoryScheme is the Scheme for a source backed by a directory tree on the local filesystem
+	DirectoryScheme Scheme = "DirectoryScheme"
+	// ImageScheme is the Scheme for a source backed by a container image
+	ImageScheme Scheme = "ImageScheme"
+	// FileScheme is the Scheme for a source backed by a single file (optionally an archive) on the local
+	// filesystem
+	FileScheme Scheme = "FileScheme"
+)
+
+// detectScheme determines the Scheme and input location implied by userInput. An explicit dir:, file:, or
+// image: prefix is trusted as-is; otherwise the local filesystem is checked before falling back to the given
+// image detector.
+func detectScheme(fs afero.Fs, imageDetector sourceDetector, userInput string) (Scheme, string, error) {
+	switch {
+	case strings.HasPrefix(userInput, "dir:"):
+		return DirectoryScheme, strings.TrimPrefix(userInput, "dir:"), nil
+	case strings.HasPrefix(userInput, "file:"):
+		return FileScheme, strings.TrimPrefix(userInput, "file:"), nil
+	case strings.HasPrefix(userInput, "image:"):
+		return ImageScheme, strings.TrimPrefix(userInput, "image:"), nil
+	}
+
+	if fileMeta, err := fs.Stat(userInput); err == nil {
+		if fileMeta.IsDir() {
+			return DirectoryScheme, userInput, nil
+		}
+		return FileScheme, userInput, nil
+	}
+
+	if _, _, err := imageDetector(userInput); err == nil {
+		return ImageScheme, userInput, nil
+	}
+
+	return UnknownScheme, "", fmt.Errorf("unable to determine source scheme for %q", userInput)
+}
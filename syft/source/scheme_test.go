@@ -0,0 +1,106 @@
+package source
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+)
+
+// image.Source is a plain string alias, so these stand-ins for a real sourceDetector don't need to import
+// stereoscope at all.
+func detectImageAlways(userInput string) (string, string, error) {
+	return "docker-archive", userInput, nil
+}
+
+func detectImageNever(userInput string) (string, string, error) {
+	return "", "", fmt.Errorf("no image found for %q", userInput)
+}
+
+func TestDetectScheme(t *testing.T) {
+	tests := []struct {
+		name          string
+		userInput     string
+		setupFs       func(fs afero.Fs)
+		imageDetector sourceDetector
+		wantScheme    Scheme
+		wantLocation  string
+		wantErr       bool
+	}{
+		{
+			name:          "dir: prefix is trusted without touching the filesystem",
+			userInput:     "dir:./some/path",
+			imageDetector: detectImageNever,
+			wantScheme:    DirectoryScheme,
+			wantLocation:  "./some/path",
+		},
+		{
+			name:          "file: prefix is trusted without touching the filesystem",
+			userInput:     "file:./some/file",
+			imageDetector: detectImageNever,
+			wantScheme:    FileScheme,
+			wantLocation:  "./some/file",
+		},
+		{
+			name:          "image: prefix is trusted without touching the filesystem",
+			userInput:     "image:some-image:latest",
+			imageDetector: detectImageNever,
+			wantScheme:    ImageScheme,
+			wantLocation:  "some-image:latest",
+		},
+		{
+			name:      "unprefixed existing directory resolves to DirectoryScheme",
+			userInput: "some-dir",
+			setupFs: func(fs afero.Fs) {
+				assert.NoError(t, fs.MkdirAll("some-dir", 0755))
+			},
+			imageDetector: detectImageNever,
+			wantScheme:    DirectoryScheme,
+			wantLocation:  "some-dir",
+		},
+		{
+			name:      "unprefixed existing file resolves to FileScheme",
+			userInput: "some-file",
+			setupFs: func(fs afero.Fs) {
+				assert.NoError(t, afero.WriteFile(fs, "some-file", []byte("hello"), 0644))
+			},
+			imageDetector: detectImageNever,
+			wantScheme:    FileScheme,
+			wantLocation:  "some-file",
+		},
+		{
+			name:          "unprefixed input missing from the filesystem falls back to the image detector",
+			userInput:     "some-image",
+			imageDetector: detectImageAlways,
+			wantScheme:    ImageScheme,
+			wantLocation:  "some-image",
+		},
+		{
+			name:          "unprefixed input matching neither the filesystem nor the image detector is unknown",
+			userInput:     "not-found-anywhere",
+			imageDetector: detectImageNever,
+			wantScheme:    UnknownScheme,
+			wantErr:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := afero.NewMemMapFs()
+			if test.setupFs != nil {
+				test.setupFs(fs)
+			}
+
+			gotScheme, gotLocation, err := detectScheme(fs, test.imageDetector, test.userInput)
+			if test.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, test.wantScheme, gotScheme)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantScheme, gotScheme)
+			assert.Equal(t, test.wantLocation, gotLocation)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package source
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// singleFileResolver is a FileResolver that exposes exactly one file on the local filesystem, used when a
+// FileScheme source refers to a plain (non-archive) file.
+type singleFileResolver struct {
+	path     string
+	location Location
+}
+
+func newSingleFileResolver(path string) *singleFileResolver {
+	return &singleFileResolver{
+		path:     path,
+		location: NewLocation(path),
+	}
+}
+
+// FileContentsByLocation returns the contents of the single file backing this resolver, regardless of the
+// given location, since there is only ever one file to resolve.
+func (r *singleFileResolver) FileContentsByLocation(_ Location) (string, error) {
+	contents, err := os.ReadFile(r.path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read file=%q: %w", r.path, err)
+	}
+	return string(contents), nil
+}
+
+// FilesByPath returns the single file location when the given path matches the resolver's file path.
+func (r *singleFileResolver) FilesByPath(paths ...string) ([]Location, error) {
+	for _, p := range paths {
+		if p == r.path {
+			return []Location{r.location}, nil
+		}
+	}
+	return nil, nil
+}
+
+// FilesByGlob returns the single file location when any of the given glob patterns match the resolver's file
+// path. Patterns are matched with doublestar (the same "**"-capable glob syntax catalogers use against a
+// directory source), so a file source behaves the same way a directory source containing just that one file
+// would.
+func (r *singleFileResolver) FilesByGlob(patterns ...string) ([]Location, error) {
+	matched := false
+	for _, pattern := range patterns {
+		matches, err := doublestar.Match(pattern, r.path)
+		if err != nil {
+			return nil, fmt.Errorf("bad glob pattern=%q: %w", pattern, err)
+		}
+		if matches {
+			matched = true
+		}
+	}
+	if !matched {
+		return nil, nil
+	}
+	return []Location{r.location}, nil
+}
+
+// AllLocations returns the single location this resolver exposes.
+func (r *singleFileResolver) AllLocations() <-chan Location {
+	ch := make(chan Location, 1)
+	ch <- r.location
+	close(ch)
+	return ch
+}
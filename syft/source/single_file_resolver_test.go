@@ -0,0 +1,80 @@
+package source
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleFileResolver_FilesByPath(t *testing.T) {
+	r := newSingleFileResolver("/some/path/file.txt")
+
+	locations, err := r.FilesByPath("/some/path/file.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, []Location{r.location}, locations)
+
+	locations, err = r.FilesByPath("/some/other/file.txt")
+	assert.NoError(t, err)
+	assert.Nil(t, locations)
+}
+
+func TestSingleFileResolver_FilesByGlob(t *testing.T) {
+	r := newSingleFileResolver("/some/path/file.txt")
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    bool
+	}{
+		{
+			name:    "exact match",
+			pattern: "/some/path/file.txt",
+			want:    true,
+		},
+		{
+			name:    "single-star matches within a path segment",
+			pattern: "/some/path/*.txt",
+			want:    true,
+		},
+		{
+			name:    "doublestar matches across path segments",
+			pattern: "**/file.txt",
+			want:    true,
+		},
+		{
+			name:    "non-matching pattern",
+			pattern: "**/other.txt",
+			want:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			locations, err := r.FilesByGlob(test.pattern)
+			assert.NoError(t, err)
+			if test.want {
+				assert.Equal(t, []Location{r.location}, locations)
+			} else {
+				assert.Nil(t, locations)
+			}
+		})
+	}
+}
+
+func TestSingleFileResolver_FilesByGlob_BadPattern(t *testing.T) {
+	r := newSingleFileResolver("/some/path/file.txt")
+
+	_, err := r.FilesByGlob("[")
+	assert.Error(t, err)
+}
+
+func TestSingleFileResolver_AllLocations(t *testing.T) {
+	r := newSingleFileResolver("/some/path/file.txt")
+
+	var got []Location
+	for l := range r.AllLocations() {
+		got = append(got, l)
+	}
+
+	assert.Equal(t, []Location{r.location}, got)
+}
@@ -18,13 +18,14 @@ import (
 // Source is an object that captures the data source to be cataloged, configuration, and a specific resolver used
 // in cataloging (based on the data source and configuration)
 type Source struct {
-	Image    *image.Image // the image object to be cataloged (image only)
-	Metadata Metadata
+	Image        *image.Image // the image object to be cataloged (image only)
+	Metadata     Metadata
+	fileResolver FileResolver // a pre-built resolver, used when the Metadata.Scheme does not imply a single resolver (file only)
 }
 
 type sourceDetector func(string) (image.Source, string, error)
 
-// New produces a Source based on userInput like dir: or image:tag
+// New produces a Source based on userInput like dir:, image:tag, or file:./path
 func New(userInput string) (Source, func(), error) {
 	fs := afero.NewOsFs()
 	parsedScheme, location, err := detectScheme(fs, image.DetectSource, userInput)
@@ -64,6 +65,13 @@ func New(userInput string) (Source, func(), error) {
 			return Source{}, cleanup, fmt.Errorf("could not populate source with image: %w", err)
 		}
 		return s, cleanup, nil
+
+	case FileScheme:
+		s, cleanup, err := NewFromFile(location)
+		if err != nil {
+			return Source{}, cleanup, fmt.Errorf("could not populate source from file=%q: %w", location, err)
+		}
+		return s, cleanup, nil
 	}
 
 	return Source{}, func() {}, fmt.Errorf("unable to process input for scanning: '%s'", userInput)
@@ -108,6 +116,11 @@ func (s Source) FileResolver(scope Scope) (FileResolver, error) {
 		default:
 			return nil, fmt.Errorf("bad image scope provided: %+v", scope)
 		}
+	case FileScheme:
+		if s.fileResolver == nil {
+			return nil, fmt.Errorf("file source has no resolver configured")
+		}
+		return s.fileResolver, nil
 	}
 	return nil, fmt.Errorf("unable to determine FileResolver with current scheme=%q", s.Metadata.Scheme)
 }